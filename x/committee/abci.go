@@ -0,0 +1,16 @@
+package committee
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// BeginBlocker runs the committee module's begin block logic. It currently has nothing to do, but is defined
+// for symmetry with EndBlocker and in case future committee features need per-block setup.
+func BeginBlocker(ctx sdk.Context, k Keeper) {}
+
+// EndBlocker runs the committee module's end block logic, enacting any proposal that has enough votes to pass
+// and closing any proposal that has reached its voting deadline, so that committees work as a live governance
+// surface without needing an external caller to trigger enactment.
+func EndBlocker(ctx sdk.Context, k Keeper) {
+	k.ProcessProposals(ctx)
+}
@@ -0,0 +1,48 @@
+package committee
+
+import (
+	"github.com/kava-labs/kava/x/committee/keeper"
+	"github.com/kava-labs/kava/x/committee/types"
+)
+
+const (
+	ModuleName            = types.ModuleName
+	StoreKey              = types.StoreKey
+	RouterKey             = types.RouterKey
+	QuerierRoute          = types.QuerierRoute
+	DefaultParamspace     = types.DefaultParamspace
+	DefaultNextProposalID = types.DefaultNextProposalID
+)
+
+var (
+	NewKeeper            = keeper.NewKeeper
+	RegisterInvariants   = keeper.RegisterInvariants
+	NewRouter            = types.NewRouter
+	RegisterCodec        = types.RegisterCodec
+	ModuleCdc            = types.ModuleCdc
+	NewMemberCommittee   = types.NewMemberCommittee
+	NewTokenCommittee    = types.NewTokenCommittee
+	NewMsgSubmitProposal = types.NewMsgSubmitProposal
+	NewMsgVote           = types.NewMsgVote
+	NewVote              = types.NewVote
+	NewGenesisState      = types.NewGenesisState
+	DefaultGenesisState  = types.DefaultGenesisState
+)
+
+type (
+	Keeper            = keeper.Keeper
+	Committee         = types.Committee
+	Committees        = types.Committees
+	BaseCommittee     = types.BaseCommittee
+	MemberCommittee   = types.MemberCommittee
+	TokenCommittee    = types.TokenCommittee
+	PubProposal       = types.PubProposal
+	Proposal          = types.Proposal
+	Proposals         = types.Proposals
+	Vote              = types.Vote
+	Votes             = types.Votes
+	VoteType          = types.VoteType
+	MsgSubmitProposal = types.MsgSubmitProposal
+	MsgVote           = types.MsgVote
+	GenesisState      = types.GenesisState
+)
@@ -0,0 +1,42 @@
+package committee
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// InitGenesis sets initial state for the committee module from a genesis file
+func InitGenesis(ctx sdk.Context, k Keeper, gs GenesisState) {
+	if err := gs.Validate(); err != nil {
+		panic(fmt.Sprintf("failed to validate %s genesis state: %s", ModuleName, err))
+	}
+
+	for _, com := range gs.Committees {
+		if err := k.SetCommittee(ctx, com); err != nil {
+			panic(fmt.Sprintf("failed to validate %s genesis state: %s", ModuleName, err))
+		}
+	}
+	for _, p := range gs.Proposals {
+		k.SetProposal(ctx, p)
+	}
+	for _, v := range gs.Votes {
+		k.SetVote(ctx, v)
+	}
+	k.SetNextProposalID(ctx, gs.NextProposalID)
+}
+
+// ExportGenesis exports the current state of the committee module
+func ExportGenesis(ctx sdk.Context, k Keeper) GenesisState {
+	nextProposalID, err := k.GetNextProposalID(ctx)
+	if err != nil {
+		nextProposalID = DefaultNextProposalID
+	}
+
+	return NewGenesisState(
+		nextProposalID,
+		k.GetAllCommittees(ctx),
+		k.GetAllProposals(ctx),
+		k.GetAllVotes(ctx),
+	)
+}
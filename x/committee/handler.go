@@ -0,0 +1,48 @@
+package committee
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/kava-labs/kava/x/committee/types"
+)
+
+// NewHandler creates an sdk.Handler for all the committee module's messages
+func NewHandler(k Keeper) sdk.Handler {
+	return func(ctx sdk.Context, msg sdk.Msg) sdk.Result {
+		ctx = ctx.WithEventManager(sdk.NewEventManager())
+		switch msg := msg.(type) {
+		case MsgSubmitProposal:
+			return handleMsgSubmitProposal(ctx, k, msg)
+		case MsgVote:
+			return handleMsgVote(ctx, k, msg)
+		default:
+			errMsg := fmt.Sprintf("unrecognized committee message type: %T", msg)
+			return sdk.ErrUnknownRequest(errMsg).Result()
+		}
+	}
+}
+
+func handleMsgSubmitProposal(ctx sdk.Context, k Keeper, msg MsgSubmitProposal) sdk.Result {
+	proposalID, err := k.SubmitProposal(ctx, msg.Proposer, msg.CommitteeID, msg.PubProposal)
+	if err != nil {
+		return err.Result()
+	}
+
+	return sdk.Result{
+		Data:   types.Uint64ToBytes(proposalID),
+		Events: ctx.EventManager().Events(),
+	}
+}
+
+func handleMsgVote(ctx sdk.Context, k Keeper, msg MsgVote) sdk.Result {
+	err := k.AddVote(ctx, msg.ProposalID, msg.Voter, msg.VoteType)
+	if err != nil {
+		return err.Result()
+	}
+
+	return sdk.Result{
+		Events: ctx.EventManager().Events(),
+	}
+}
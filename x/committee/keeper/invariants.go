@@ -0,0 +1,43 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/kava-labs/kava/x/committee/types"
+)
+
+// RegisterInvariants registers all committee invariants
+func RegisterInvariants(ir sdk.InvariantRegistry, k Keeper) {
+	ir.RegisterRoute(types.ModuleName, "valid-proposal-deadlines", ValidProposalDeadlinesInvariant(k))
+}
+
+// AllInvariants runs all invariants of the committee module
+func AllInvariants(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		return ValidProposalDeadlinesInvariant(k)(ctx)
+	}
+}
+
+// ValidProposalDeadlinesInvariant checks that no proposal is stored past its voting deadline. ProcessProposals
+// runs every EndBlock and must close a proposal (via enactment or CloseExpiredProposals) no later than the block
+// in which it expires, so finding one violates that guarantee.
+func ValidProposalDeadlinesInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var expired types.Proposals
+		k.IterateProposals(ctx, func(proposal types.Proposal) bool {
+			if proposal.HasExpiredBy(ctx.BlockTime()) {
+				expired = append(expired, proposal)
+			}
+			return false
+		})
+
+		broken := len(expired) > 0
+		return sdk.FormatInvariant(
+			types.ModuleName,
+			"valid-proposal-deadlines",
+			fmt.Sprintf("found %d proposals past their deadline\n%s", len(expired), expired),
+		), broken
+	}
+}
@@ -0,0 +1,28 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/kava-labs/kava/x/committee/keeper"
+)
+
+func TestValidProposalDeadlinesInvariant(t *testing.T) {
+	ctx, k, _ := setupKeeper(t)
+
+	_, broken := keeper.ValidProposalDeadlinesInvariant(k)(ctx)
+	require.False(t, broken, "invariant should not be broken when there are no proposals")
+
+	expiredID, err := k.StoreNewProposal(ctx, testPubProposal{Route: "testroute"}, 1, ctx.BlockTime().Add(-time.Hour))
+	require.NoError(t, err)
+
+	_, broken = keeper.ValidProposalDeadlinesInvariant(k)(ctx)
+	require.True(t, broken, "invariant should be broken once a proposal is stored past its deadline")
+
+	k.DeleteProposalAndVotes(ctx, expiredID)
+
+	_, broken = keeper.ValidProposalDeadlinesInvariant(k)(ctx)
+	require.False(t, broken, "invariant should no longer be broken once the expired proposal is removed")
+}
@@ -0,0 +1,248 @@
+package keeper
+
+import (
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/kava-labs/kava/x/committee/types"
+)
+
+// Keeper of the committee store
+type Keeper struct {
+	key           sdk.StoreKey
+	cdc           *codec.Codec
+	router        types.Router
+	codespace     sdk.CodespaceType
+	stakingKeeper types.StakingKeeper
+	supplyKeeper  types.SupplyKeeper
+	paramKeeper   types.ParamKeeper
+}
+
+// NewKeeper creates a new committee Keeper
+func NewKeeper(cdc *codec.Codec, key sdk.StoreKey, router types.Router, stakingKeeper types.StakingKeeper, supplyKeeper types.SupplyKeeper, paramKeeper types.ParamKeeper, codespace sdk.CodespaceType) Keeper {
+	return Keeper{
+		key:           key,
+		cdc:           cdc,
+		router:        router,
+		codespace:     codespace,
+		stakingKeeper: stakingKeeper,
+		supplyKeeper:  supplyKeeper,
+		paramKeeper:   paramKeeper,
+	}
+}
+
+// Codespace returns the committee module's codespace
+func (k Keeper) Codespace() sdk.CodespaceType {
+	return k.codespace
+}
+
+// GetCommittee gets a committee from the store
+func (k Keeper) GetCommittee(ctx sdk.Context, committeeID uint64) (types.Committee, bool) {
+	store := ctx.KVStore(k.key)
+	bz := store.Get(types.GetCommitteeKey(committeeID))
+	if bz == nil {
+		return nil, false
+	}
+	var committee types.Committee
+	k.cdc.MustUnmarshalBinaryBare(bz, &committee)
+	return committee, true
+}
+
+// SetCommittee puts a committee into the store, rejecting token committees whose tally denom is the staking bond
+// denom, as tallying votes for one would double-count the stake already used to weight staking's own governance.
+func (k Keeper) SetCommittee(ctx sdk.Context, committee types.Committee) sdk.Error {
+	if tc, ok := committee.(types.TokenCommittee); ok && tc.TallyDenom == k.stakingKeeper.BondDenom(ctx) {
+		return types.ErrInvalidTallyDenom(k.codespace, tc.TallyDenom)
+	}
+
+	store := ctx.KVStore(k.key)
+	bz := k.cdc.MustMarshalBinaryBare(committee)
+	store.Set(types.GetCommitteeKey(committee.GetID()), bz)
+	return nil
+}
+
+// DeleteCommittee removes a committee from the store
+func (k Keeper) DeleteCommittee(ctx sdk.Context, committeeID uint64) {
+	store := ctx.KVStore(k.key)
+	store.Delete(types.GetCommitteeKey(committeeID))
+}
+
+// IterateCommittees provides an iterator over all stored committees, calling cb for each one.
+// Stops iteration when cb returns true.
+func (k Keeper) IterateCommittees(ctx sdk.Context, cb func(committee types.Committee) (stop bool)) {
+	store := ctx.KVStore(k.key)
+	iterator := sdk.KVStorePrefixIterator(store, types.CommitteeKeyPrefix)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var committee types.Committee
+		k.cdc.MustUnmarshalBinaryBare(iterator.Value(), &committee)
+		if cb(committee) {
+			break
+		}
+	}
+}
+
+// GetAllCommittees returns all committees currently in the store
+func (k Keeper) GetAllCommittees(ctx sdk.Context) types.Committees {
+	var committees types.Committees
+	k.IterateCommittees(ctx, func(committee types.Committee) bool {
+		committees = append(committees, committee)
+		return false
+	})
+	return committees
+}
+
+// GetNextProposalID gets the next available global proposal ID
+func (k Keeper) GetNextProposalID(ctx sdk.Context) (uint64, sdk.Error) {
+	store := ctx.KVStore(k.key)
+	bz := store.Get(types.NextProposalIDKey)
+	if bz == nil {
+		return 0, types.ErrUnknownProposal(k.codespace, 0)
+	}
+	return types.Uint64FromBytes(bz), nil
+}
+
+// SetNextProposalID sets the next available global proposal ID
+func (k Keeper) SetNextProposalID(ctx sdk.Context, id uint64) {
+	store := ctx.KVStore(k.key)
+	store.Set(types.NextProposalIDKey, types.Uint64ToBytes(id))
+}
+
+// StoreNewProposal stores a pub proposal, assigning it the next available ID, and increments the next ID.
+func (k Keeper) StoreNewProposal(ctx sdk.Context, pubProposal types.PubProposal, committeeID uint64, deadline time.Time) (uint64, sdk.Error) {
+	newProposalID, err := k.GetNextProposalID(ctx)
+	if err != nil {
+		return 0, err
+	}
+	proposal := types.NewProposal(pubProposal, newProposalID, committeeID, deadline)
+	k.SetProposal(ctx, proposal)
+	k.SetNextProposalID(ctx, newProposalID+1)
+	return newProposalID, nil
+}
+
+// GetProposal gets a proposal from the store
+func (k Keeper) GetProposal(ctx sdk.Context, proposalID uint64) (types.Proposal, bool) {
+	store := ctx.KVStore(k.key)
+	bz := store.Get(types.GetProposalKey(proposalID))
+	if bz == nil {
+		return types.Proposal{}, false
+	}
+	var proposal types.Proposal
+	k.cdc.MustUnmarshalBinaryBare(bz, &proposal)
+	return proposal, true
+}
+
+// SetProposal puts a proposal into the store
+func (k Keeper) SetProposal(ctx sdk.Context, proposal types.Proposal) {
+	store := ctx.KVStore(k.key)
+	bz := k.cdc.MustMarshalBinaryBare(proposal)
+	store.Set(types.GetProposalKey(proposal.ID), bz)
+}
+
+// DeleteProposal removes a proposal from the store
+func (k Keeper) DeleteProposal(ctx sdk.Context, proposalID uint64) {
+	store := ctx.KVStore(k.key)
+	store.Delete(types.GetProposalKey(proposalID))
+}
+
+// IterateProposals provides an iterator over all stored proposals, calling cb for each one.
+// Stops iteration when cb returns true.
+func (k Keeper) IterateProposals(ctx sdk.Context, cb func(proposal types.Proposal) (stop bool)) {
+	store := ctx.KVStore(k.key)
+	iterator := sdk.KVStorePrefixIterator(store, types.ProposalKeyPrefix)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var proposal types.Proposal
+		k.cdc.MustUnmarshalBinaryBare(iterator.Value(), &proposal)
+		if cb(proposal) {
+			break
+		}
+	}
+}
+
+// GetAllProposals returns all proposals currently in the store
+func (k Keeper) GetAllProposals(ctx sdk.Context) types.Proposals {
+	var proposals types.Proposals
+	k.IterateProposals(ctx, func(proposal types.Proposal) bool {
+		proposals = append(proposals, proposal)
+		return false
+	})
+	return proposals
+}
+
+// GetVote gets a vote from the store
+func (k Keeper) GetVote(ctx sdk.Context, proposalID uint64, voter sdk.AccAddress) (types.Vote, bool) {
+	store := ctx.KVStore(k.key)
+	bz := store.Get(types.GetVoteKey(proposalID, voter))
+	if bz == nil {
+		return types.Vote{}, false
+	}
+	var vote types.Vote
+	k.cdc.MustUnmarshalBinaryBare(bz, &vote)
+	return vote, true
+}
+
+// SetVote puts a vote into the store
+func (k Keeper) SetVote(ctx sdk.Context, vote types.Vote) {
+	store := ctx.KVStore(k.key)
+	bz := k.cdc.MustMarshalBinaryBare(vote)
+	store.Set(types.GetVoteKey(vote.ProposalID, vote.Voter), bz)
+}
+
+// DeleteVote removes a vote from the store
+func (k Keeper) DeleteVote(ctx sdk.Context, proposalID uint64, voter sdk.AccAddress) {
+	store := ctx.KVStore(k.key)
+	store.Delete(types.GetVoteKey(proposalID, voter))
+}
+
+// IterateVotes provides an iterator over all votes stored for a proposal, calling cb for each one.
+// Stops iteration when cb returns true.
+func (k Keeper) IterateVotes(ctx sdk.Context, proposalID uint64, cb func(vote types.Vote) (stop bool)) {
+	store := ctx.KVStore(k.key)
+	iterator := sdk.KVStorePrefixIterator(store, types.GetVoteKeyPrefix(proposalID))
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var vote types.Vote
+		k.cdc.MustUnmarshalBinaryBare(iterator.Value(), &vote)
+		if cb(vote) {
+			break
+		}
+	}
+}
+
+// GetVotes returns all votes cast on a proposal
+func (k Keeper) GetVotes(ctx sdk.Context, proposalID uint64) types.Votes {
+	var votes types.Votes
+	k.IterateVotes(ctx, proposalID, func(vote types.Vote) bool {
+		votes = append(votes, vote)
+		return false
+	})
+	return votes
+}
+
+// IterateAllVotes provides an iterator over every vote stored, across all proposals, calling cb for each one.
+// Stops iteration when cb returns true.
+func (k Keeper) IterateAllVotes(ctx sdk.Context, cb func(vote types.Vote) (stop bool)) {
+	store := ctx.KVStore(k.key)
+	iterator := sdk.KVStorePrefixIterator(store, types.VoteKeyPrefix)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var vote types.Vote
+		k.cdc.MustUnmarshalBinaryBare(iterator.Value(), &vote)
+		if cb(vote) {
+			break
+		}
+	}
+}
+
+// GetAllVotes returns every vote currently stored, across all proposals
+func (k Keeper) GetAllVotes(ctx sdk.Context) types.Votes {
+	var votes types.Votes
+	k.IterateAllVotes(ctx, func(vote types.Vote) bool {
+		votes = append(votes, vote)
+		return false
+	})
+	return votes
+}
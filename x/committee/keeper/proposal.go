@@ -19,8 +19,15 @@ func (k Keeper) SubmitProposal(ctx sdk.Context, proposer sdk.AccAddress, committ
 		return 0, sdk.ErrUnauthorized("proposer not member of committee")
 	}
 
-	// Check committee has permissions to enact proposal.
-	if !com.HasPermissionsFor(pubProposal) {
+	// Check committee has a permission that allows this proposal.
+	hasPermission := false
+	for _, p := range com.GetPermissions() {
+		if p.Allows(ctx, k.cdc, k.paramKeeper, pubProposal) {
+			hasPermission = true
+			break
+		}
+	}
+	if !hasPermission {
 		return 0, sdk.ErrUnauthorized("committee does not have permissions to enact proposal")
 	}
 
@@ -30,7 +37,7 @@ func (k Keeper) SubmitProposal(ctx sdk.Context, proposer sdk.AccAddress, committ
 	}
 
 	// Get a new ID and store the proposal
-	deadline := ctx.BlockTime().Add(com.ProposalDuration)
+	deadline := ctx.BlockTime().Add(com.GetProposalDuration())
 	proposalID, err := k.StoreNewProposal(ctx, pubProposal, committeeID, deadline)
 	if err != nil {
 		return 0, err
@@ -39,7 +46,7 @@ func (k Keeper) SubmitProposal(ctx sdk.Context, proposer sdk.AccAddress, committ
 	ctx.EventManager().EmitEvent(
 		sdk.NewEvent(
 			types.EventTypeProposalSubmit,
-			sdk.NewAttribute(types.AttributeKeyCommitteeID, fmt.Sprintf("%d", com.ID)),
+			sdk.NewAttribute(types.AttributeKeyCommitteeID, fmt.Sprintf("%d", com.GetID())),
 			sdk.NewAttribute(types.AttributeKeyProposalID, fmt.Sprintf("%d", proposalID)),
 		),
 	)
@@ -47,8 +54,13 @@ func (k Keeper) SubmitProposal(ctx sdk.Context, proposer sdk.AccAddress, committ
 }
 
 // AddVote submits a vote on a proposal.
-func (k Keeper) AddVote(ctx sdk.Context, proposalID uint64, voter sdk.AccAddress) sdk.Error {
+// Member committees only permit members to vote; token committees permit any address to vote, as votes are
+// weighted by each voter's stake in the committee's tally denom.
+func (k Keeper) AddVote(ctx sdk.Context, proposalID uint64, voter sdk.AccAddress, voteType types.VoteType) sdk.Error {
 	// Validate
+	if !voteType.Valid() {
+		return types.ErrInvalidVoteType(k.codespace, voteType)
+	}
 	pr, found := k.GetProposal(ctx, proposalID)
 	if !found {
 		return types.ErrUnknownProposal(k.codespace, proposalID)
@@ -60,24 +72,31 @@ func (k Keeper) AddVote(ctx sdk.Context, proposalID uint64, voter sdk.AccAddress
 	if !found {
 		return types.ErrUnknownCommittee(k.codespace, pr.CommitteeID)
 	}
-	if !com.HasMember(voter) {
-		return sdk.ErrUnauthorized("voter must be a member of committee")
+	if _, isTokenCommittee := com.(types.TokenCommittee); !isTokenCommittee {
+		if !com.HasMember(voter) {
+			return sdk.ErrUnauthorized("voter must be a member of committee")
+		}
 	}
 
 	// Store vote, overwriting any prior vote
-	k.SetVote(ctx, types.Vote{ProposalID: proposalID, Voter: voter})
+	k.SetVote(ctx, types.NewVote(proposalID, voter, voteType))
 
 	ctx.EventManager().EmitEvent(
 		sdk.NewEvent(
 			types.EventTypeProposalVote,
-			sdk.NewAttribute(types.AttributeKeyCommitteeID, fmt.Sprintf("%d", com.ID)),
+			sdk.NewAttribute(types.AttributeKeyCommitteeID, fmt.Sprintf("%d", com.GetID())),
 			sdk.NewAttribute(types.AttributeKeyProposalID, fmt.Sprintf("%d", pr.ID)),
+			sdk.NewAttribute(types.AttributeKeyVoter, voter.String()),
+			sdk.NewAttribute(types.AttributeKeyVoteType, voteType.String()),
 		),
 	)
 	return nil
 }
 
 // GetProposalResult calculates if a proposal currently has enough votes to pass.
+// Member committees require a simple majority vote threshold of their members.
+// Token committees require both a minimum quorum of the tally denom's total supply to have voted, and a minimum
+// fraction of the non-abstaining vote weight to be in favor.
 // TODO rename GetProposalTally?
 func (k Keeper) GetProposalResult(ctx sdk.Context, proposalID uint64) (bool, sdk.Error) {
 	pr, found := k.GetProposal(ctx, proposalID)
@@ -89,40 +108,119 @@ func (k Keeper) GetProposalResult(ctx sdk.Context, proposalID uint64) (bool, sdk
 		return false, types.ErrUnknownCommittee(k.codespace, pr.CommitteeID)
 	}
 
-	numVotes := k.TallyVotes(ctx, proposalID)
+	yesVotes, noVotes, abstainVotes, err := k.TallyVotes(ctx, proposalID)
+	if err != nil {
+		return false, err
+	}
 
-	proposalResult := sdk.NewDec(numVotes).GTE(com.VoteThreshold.MulInt64(int64(len(com.Members))))
+	switch com := com.(type) {
+	case types.TokenCommittee:
+		totalSupply := k.supplyKeeper.GetSupply(ctx).GetTotal().AmountOf(com.TallyDenom)
+		if totalSupply.IsZero() {
+			return false, nil
+		}
+		totalVotes := yesVotes.Add(noVotes).Add(abstainVotes)
+		quorumReached := totalVotes.QuoInt(totalSupply).GTE(com.Quorum)
+		if !quorumReached {
+			return false, nil
+		}
+		decisiveVotes := yesVotes.Add(noVotes)
+		if decisiveVotes.IsZero() {
+			return false, nil
+		}
+		return yesVotes.Quo(decisiveVotes).GTE(com.VoteThreshold), nil
 
-	return proposalResult, nil
+	default: // types.MemberCommittee and any other member-based committee
+		totalVotePower := sdk.NewDec(int64(len(com.GetMembers())))
+		if totalVotePower.IsZero() {
+			return false, nil
+		}
+		return yesVotes.Quo(totalVotePower).GTE(com.GetVoteThreshold()), nil
+	}
 }
 
-// TallyVotes counts all the votes on a proposal
-func (k Keeper) TallyVotes(ctx sdk.Context, proposalID uint64) int64 {
+// TallyVotes counts all the votes on a proposal, weighting them according to the proposal's committee type.
+// Member committees weight every vote equally; token committees weight each vote by the voter's bonded and
+// delegated stake in the committee's tally denom.
+func (k Keeper) TallyVotes(ctx sdk.Context, proposalID uint64) (yesVotes, noVotes, abstainVotes sdk.Dec, err sdk.Error) {
+	pr, found := k.GetProposal(ctx, proposalID)
+	if !found {
+		return sdk.ZeroDec(), sdk.ZeroDec(), sdk.ZeroDec(), types.ErrUnknownProposal(k.codespace, proposalID)
+	}
+	com, found := k.GetCommittee(ctx, pr.CommitteeID)
+	if !found {
+		return sdk.ZeroDec(), sdk.ZeroDec(), sdk.ZeroDec(), types.ErrUnknownCommittee(k.codespace, pr.CommitteeID)
+	}
+
+	yesVotes, noVotes, abstainVotes = sdk.ZeroDec(), sdk.ZeroDec(), sdk.ZeroDec()
+
+	// A token committee's tally denom is checked against the staking bond denom once, in Keeper.SetCommittee,
+	// before the committee is ever stored - no token committee in the store can have TallyDenom == BondDenom.
+	tokenCommittee, isTokenCommittee := com.(types.TokenCommittee)
 
-	var votes []types.Vote
 	k.IterateVotes(ctx, proposalID, func(vote types.Vote) bool {
-		votes = append(votes, vote)
+		weight := sdk.OneDec()
+		if isTokenCommittee {
+			weight = sdk.NewDecFromInt(k.stakingKeeper.GetDelegatorBonded(ctx, vote.Voter, tokenCommittee.TallyDenom))
+		}
+		switch vote.VoteType {
+		case types.Yes:
+			yesVotes = yesVotes.Add(weight)
+		case types.No:
+			noVotes = noVotes.Add(weight)
+		case types.Abstain:
+			abstainVotes = abstainVotes.Add(weight)
+		}
 		return false
 	})
 
-	return int64(len(votes))
+	return yesVotes, noVotes, abstainVotes, nil
 }
 
-// EnactProposal makes the changes proposed in a proposal.
+// EnactProposal makes the changes proposed in a proposal. It runs the proposal's handler against a cached context
+// so that a handler error, or even a handler panic (eg a param change proposal with a registered subspace but an
+// unregistered key), cannot leave a partially applied state change or halt the chain. The proposal's changes are only
+// written back to the real state if the handler completes without error or panic; the proposal is then left in the
+// store for the caller to remove. Otherwise the proposal is deleted and an enact-failed close event is emitted, so
+// committees can safely enact proposals whose handlers may misbehave.
 func (k Keeper) EnactProposal(ctx sdk.Context, proposalID uint64) sdk.Error {
 	pr, found := k.GetProposal(ctx, proposalID)
 	if !found {
 		return types.ErrUnknownProposal(k.codespace, proposalID)
 	}
 
-	if err := k.ValidatePubProposal(ctx, pr.PubProposal); err != nil {
-		return err
+	err := k.enactProposal(ctx, pr.PubProposal)
+	if err != nil {
+		k.DeleteProposalAndVotes(ctx, proposalID)
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeProposalClose,
+				sdk.NewAttribute(types.AttributeKeyCommitteeID, fmt.Sprintf("%d", pr.CommitteeID)),
+				sdk.NewAttribute(types.AttributeKeyProposalID, fmt.Sprintf("%d", pr.ID)),
+				sdk.NewAttribute(types.AttributeKeyProposalCloseStatus, types.AttributeValueProposalEnactFailed),
+			),
+		)
 	}
-	handler := k.router.GetRoute(pr.ProposalRoute())
-	if err := handler(ctx, pr.PubProposal); err != nil {
-		// the handler should not error as it was checked in ValidatePubProposal
-		panic(fmt.Sprintf("unexpected handler error: %s", err))
+	return err
+}
+
+// enactProposal runs a pub proposal's handler against a cached context, converting a handler error or panic into
+// a returned ErrInvalidPubProposal, and only commits the cached changes if the handler succeeds.
+func (k Keeper) enactProposal(ctx sdk.Context, pubProposal types.PubProposal) (returnErr sdk.Error) {
+	cacheCtx, writeCache := ctx.CacheContext()
+
+	defer func() {
+		if r := recover(); r != nil {
+			returnErr = types.ErrInvalidPubProposal(k.codespace, fmt.Sprintf("proposal handler panicked: %s", r))
+		}
+	}()
+
+	handler := k.router.GetRoute(pubProposal.ProposalRoute())
+	if err := handler(cacheCtx, pubProposal); err != nil {
+		return types.ErrInvalidPubProposal(k.codespace, fmt.Sprintf("proposal handler returned an error: %s", err))
 	}
+
+	writeCache()
 	return nil
 }
 
@@ -132,6 +230,7 @@ func (k Keeper) CloseExpiredProposals(ctx sdk.Context) {
 
 	k.IterateProposals(ctx, func(proposal types.Proposal) bool {
 		if proposal.HasExpiredBy(ctx.BlockTime()) {
+			yesVotes, noVotes, abstainVotes, _ := k.TallyVotes(ctx, proposal.ID)
 
 			k.DeleteProposalAndVotes(ctx, proposal.ID)
 
@@ -141,6 +240,9 @@ func (k Keeper) CloseExpiredProposals(ctx sdk.Context) {
 					sdk.NewAttribute(types.AttributeKeyCommitteeID, fmt.Sprintf("%d", proposal.CommitteeID)),
 					sdk.NewAttribute(types.AttributeKeyProposalID, fmt.Sprintf("%d", proposal.ID)),
 					sdk.NewAttribute(types.AttributeKeyProposalCloseStatus, types.AttributeValueProposalTimeout),
+					sdk.NewAttribute(types.AttributeKeyProposalTallyYes, yesVotes.String()),
+					sdk.NewAttribute(types.AttributeKeyProposalTallyNo, noVotes.String()),
+					sdk.NewAttribute(types.AttributeKeyProposalTallyAbstain, abstainVotes.String()),
 				),
 			)
 		}
@@ -148,6 +250,45 @@ func (k Keeper) CloseExpiredProposals(ctx sdk.Context) {
 	})
 }
 
+// ProcessProposals walks over all proposals, enacting and closing any that currently have enough votes to pass,
+// and closing any that have passed their voting deadline via CloseExpiredProposals. It is intended to be called
+// once per block so that every proposal is resolved no later than the block in which it expires.
+func (k Keeper) ProcessProposals(ctx sdk.Context) {
+	k.IterateProposals(ctx, func(proposal types.Proposal) bool {
+		if proposal.HasExpiredBy(ctx.BlockTime()) {
+			return false // timed out proposals are closed by CloseExpiredProposals below
+		}
+
+		passed, err := k.GetProposalResult(ctx, proposal.ID)
+		if err != nil || !passed {
+			return false
+		}
+
+		if err := k.EnactProposal(ctx, proposal.ID); err != nil {
+			// EnactProposal has already deleted the proposal and emitted an enact-failed close event
+			return false
+		}
+
+		yesVotes, noVotes, abstainVotes, _ := k.TallyVotes(ctx, proposal.ID)
+
+		k.DeleteProposalAndVotes(ctx, proposal.ID)
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeProposalClose,
+				sdk.NewAttribute(types.AttributeKeyCommitteeID, fmt.Sprintf("%d", proposal.CommitteeID)),
+				sdk.NewAttribute(types.AttributeKeyProposalID, fmt.Sprintf("%d", proposal.ID)),
+				sdk.NewAttribute(types.AttributeKeyProposalCloseStatus, types.AttributeValueProposalPassed),
+				sdk.NewAttribute(types.AttributeKeyProposalTallyYes, yesVotes.String()),
+				sdk.NewAttribute(types.AttributeKeyProposalTallyNo, noVotes.String()),
+				sdk.NewAttribute(types.AttributeKeyProposalTallyAbstain, abstainVotes.String()),
+			),
+		)
+		return false
+	})
+
+	k.CloseExpiredProposals(ctx)
+}
+
 // ValidatePubProposal checks if a pubproposal is valid.
 func (k Keeper) ValidatePubProposal(ctx sdk.Context, pubProposal types.PubProposal) (returnErr sdk.Error) {
 	if pubProposal == nil {
@@ -194,4 +335,4 @@ func (k Keeper) DeleteProposalAndVotes(ctx sdk.Context, proposalID uint64) {
 	for _, v := range votes {
 		k.DeleteVote(ctx, v.ProposalID, v.Voter)
 	}
-}
\ No newline at end of file
+}
@@ -0,0 +1,294 @@
+package keeper_test
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/kava-labs/kava/x/committee/types"
+)
+
+func newTestMemberCommittee(id uint64, members []sdk.AccAddress) types.MemberCommittee {
+	return types.NewMemberCommittee(
+		id,
+		"a test committee",
+		members,
+		[]types.Permission{types.GodPermission{}},
+		sdk.MustNewDecFromStr("0.5"),
+		time.Hour,
+	)
+}
+
+func TestKeeper_TallyVotes(t *testing.T) {
+	ctx, k, _ := setupKeeper(t)
+
+	members := []sdk.AccAddress{
+		sdk.AccAddress("voterAAAAAAAAAAAAAAA"),
+		sdk.AccAddress("voterBBBBBBBBBBBBBBB"),
+		sdk.AccAddress("voterCCCCCCCCCCCCCCC"),
+	}
+	com := newTestMemberCommittee(1, members)
+	require.NoError(t, k.SetCommittee(ctx, com))
+
+	proposalID, err := k.StoreNewProposal(ctx, testPubProposal{Route: "testroute"}, com.ID, ctx.BlockTime().Add(time.Hour))
+	require.NoError(t, err)
+
+	require.NoError(t, k.AddVote(ctx, proposalID, members[0], types.Yes))
+	require.NoError(t, k.AddVote(ctx, proposalID, members[1], types.No))
+	require.NoError(t, k.AddVote(ctx, proposalID, members[2], types.Abstain))
+
+	yesVotes, noVotes, abstainVotes, err := k.TallyVotes(ctx, proposalID)
+	require.NoError(t, err)
+	require.True(t, yesVotes.Equal(sdk.OneDec()), "expected 1 yes vote, got %s", yesVotes)
+	require.True(t, noVotes.Equal(sdk.OneDec()), "expected 1 no vote, got %s", noVotes)
+	require.True(t, abstainVotes.Equal(sdk.OneDec()), "expected 1 abstain vote, got %s", abstainVotes)
+}
+
+func newTestTokenCommittee(id uint64, members []sdk.AccAddress, quorum, threshold sdk.Dec, tallyDenom string) types.TokenCommittee {
+	return types.NewTokenCommittee(
+		id,
+		"a test token committee",
+		members,
+		[]types.Permission{types.GodPermission{}},
+		threshold,
+		time.Hour,
+		quorum,
+		tallyDenom,
+	)
+}
+
+func TestKeeper_GetProposalResult_TokenCommittee(t *testing.T) {
+	const tallyDenom = "hard"
+
+	voters := []sdk.AccAddress{
+		sdk.AccAddress("voterAAAAAAAAAAAAAAA"),
+		sdk.AccAddress("voterBBBBBBBBBBBBBBB"),
+		sdk.AccAddress("voterCCCCCCCCCCCCCCC"),
+	}
+
+	testCases := []struct {
+		name        string
+		bonded      map[string]sdk.Int // keyed by voter address
+		votes       map[int]types.VoteType
+		totalSupply sdk.Int
+		expectPass  bool
+	}{
+		{
+			name:   "quorum and threshold reached, passes",
+			bonded: map[string]sdk.Int{voters[0].String(): sdk.NewInt(30), voters[1].String(): sdk.NewInt(20), voters[2].String(): sdk.NewInt(10)},
+			votes: map[int]types.VoteType{
+				0: types.Yes, // 30 yes
+				1: types.No,  // 20 no
+				2: types.Abstain,
+			}, // 60 of 100 voted (quorum 0.5 reached); yes/(yes+no) = 30/50 = 0.6 >= threshold 0.5
+			totalSupply: sdk.NewInt(100),
+			expectPass:  true,
+		},
+		{
+			name:   "quorum not reached, fails despite unanimous yes",
+			bonded: map[string]sdk.Int{voters[0].String(): sdk.NewInt(10)},
+			votes: map[int]types.VoteType{
+				0: types.Yes,
+			}, // only 10 of 100 voted; quorum 0.5 not reached
+			totalSupply: sdk.NewInt(100),
+			expectPass:  false,
+		},
+		{
+			name:   "quorum reached but threshold not, fails",
+			bonded: map[string]sdk.Int{voters[0].String(): sdk.NewInt(20), voters[1].String(): sdk.NewInt(40)},
+			votes: map[int]types.VoteType{
+				0: types.Yes, // 20 yes
+				1: types.No,  // 40 no
+			}, // 60 of 100 voted (quorum reached); yes/(yes+no) = 20/60 = 0.33 < threshold 0.5
+			totalSupply: sdk.NewInt(100),
+			expectPass:  false,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx, k, _ := newTestKeeper(
+				t,
+				mockStakingKeeper{bondDenom: "ukava", bonded: tc.bonded},
+				mockSupplyKeeper{totalSupply: sdk.NewCoins(sdk.NewCoin(tallyDenom, tc.totalSupply))},
+			)
+
+			com := newTestTokenCommittee(1, []sdk.AccAddress{voters[0]}, sdk.MustNewDecFromStr("0.5"), sdk.MustNewDecFromStr("0.5"), tallyDenom)
+			require.NoError(t, k.SetCommittee(ctx, com))
+
+			proposalID, err := k.StoreNewProposal(ctx, testPubProposal{Route: "testroute"}, com.ID, ctx.BlockTime().Add(time.Hour))
+			require.NoError(t, err)
+
+			for i, voteType := range tc.votes {
+				// token committees must accept votes from addresses that aren't members
+				require.NoError(t, k.AddVote(ctx, proposalID, voters[i], voteType))
+			}
+
+			passed, err := k.GetProposalResult(ctx, proposalID)
+			require.NoError(t, err)
+			require.Equal(t, tc.expectPass, passed)
+		})
+	}
+}
+
+func TestKeeper_AddVote_TokenCommitteeAllowsNonMembers(t *testing.T) {
+	const tallyDenom = "hard"
+	ctx, k, _ := newTestKeeper(
+		t,
+		mockStakingKeeper{bondDenom: "ukava"},
+		mockSupplyKeeper{totalSupply: sdk.NewCoins()},
+	)
+
+	member := sdk.AccAddress("committeeMemberAAAAA")
+	nonMember := sdk.AccAddress("nonMemberAAAAAAAAAAA")
+
+	com := newTestTokenCommittee(1, []sdk.AccAddress{member}, sdk.MustNewDecFromStr("0.5"), sdk.MustNewDecFromStr("0.5"), tallyDenom)
+	require.NoError(t, k.SetCommittee(ctx, com))
+
+	proposalID, err := k.StoreNewProposal(ctx, testPubProposal{Route: "testroute"}, com.ID, ctx.BlockTime().Add(time.Hour))
+	require.NoError(t, err)
+
+	require.NoError(t, k.AddVote(ctx, proposalID, nonMember, types.Yes))
+}
+
+func TestKeeper_SetCommittee_RejectsTokenCommitteeWithBondDenom(t *testing.T) {
+	ctx, k, _ := setupKeeper(t)
+
+	com := types.NewTokenCommittee(
+		1,
+		"a test committee",
+		[]sdk.AccAddress{sdk.AccAddress("voterAAAAAAAAAAAAAAA")},
+		[]types.Permission{types.GodPermission{}},
+		sdk.MustNewDecFromStr("0.5"),
+		time.Hour,
+		sdk.MustNewDecFromStr("0.5"),
+		"ukava", // matches the bond denom used by mockStakingKeeper in setupKeeper
+	)
+
+	err := k.SetCommittee(ctx, com)
+	require.Error(t, err)
+
+	_, found := k.GetCommittee(ctx, com.ID)
+	require.False(t, found, "a rejected committee should never be written to the store")
+}
+
+func TestKeeper_EnactProposal(t *testing.T) {
+	testCases := []struct {
+		name        string
+		handler     types.Handler
+		expectError bool
+	}{
+		{
+			name:        "handler succeeds",
+			handler:     func(sdk.Context, types.PubProposal) sdk.Error { return nil },
+			expectError: false,
+		},
+		{
+			name:        "handler returns an error",
+			handler:     func(sdk.Context, types.PubProposal) sdk.Error { return sdk.ErrUnknownRequest("nope") },
+			expectError: true,
+		},
+		{
+			name:        "handler panics",
+			handler:     func(sdk.Context, types.PubProposal) sdk.Error { panic("proposal handler blew up") },
+			expectError: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx, k, router := setupKeeper(t)
+			router.AddRoute("testroute", tc.handler)
+
+			proposalID, err := k.StoreNewProposal(ctx, testPubProposal{Route: "testroute"}, 1, ctx.BlockTime().Add(time.Hour))
+			require.NoError(t, err)
+
+			err = k.EnactProposal(ctx, proposalID)
+			if tc.expectError {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+
+			_, found := k.GetProposal(ctx, proposalID)
+			require.Equal(t, !tc.expectError, found, "EnactProposal should remove the proposal only when enactment fails")
+		})
+	}
+}
+
+// closeEvents extracts, from events, the proposal_close attributes (keyed by proposal ID) emitted during a single
+// ProcessProposals call, so a test can assert exactly which status each proposal closed with.
+func closeEvents(events sdk.Events) map[string]string {
+	statusesByProposalID := make(map[string]string)
+	for _, event := range events {
+		if event.Type != types.EventTypeProposalClose {
+			continue
+		}
+		var proposalID, status string
+		for _, attr := range event.Attributes {
+			switch string(attr.Key) {
+			case types.AttributeKeyProposalID:
+				proposalID = string(attr.Value)
+			case types.AttributeKeyProposalCloseStatus:
+				status = string(attr.Value)
+			}
+		}
+		statusesByProposalID[proposalID] = status
+	}
+	return statusesByProposalID
+}
+
+func TestKeeper_ProcessProposals(t *testing.T) {
+	ctx, k, router := setupKeeper(t)
+
+	var failingHandlerCalled bool
+	router.AddRoute("passingroute", func(sdk.Context, types.PubProposal) sdk.Error { return nil })
+	router.AddRoute("failingroute", func(sdk.Context, types.PubProposal) sdk.Error {
+		failingHandlerCalled = true
+		return sdk.ErrUnknownRequest("proposal handler rejected this proposal")
+	})
+	router.AddRoute("timeoutroute", func(sdk.Context, types.PubProposal) sdk.Error {
+		t.Fatal("a timed out proposal's handler should never be invoked")
+		return nil
+	})
+
+	members := []sdk.AccAddress{
+		sdk.AccAddress("voterAAAAAAAAAAAAAAA"),
+		sdk.AccAddress("voterBBBBBBBBBBBBBBB"),
+	}
+	com := newTestMemberCommittee(1, members)
+	require.NoError(t, k.SetCommittee(ctx, com))
+
+	passingID, err := k.StoreNewProposal(ctx, testPubProposal{Route: "passingroute"}, com.ID, ctx.BlockTime().Add(time.Hour))
+	require.NoError(t, err)
+	require.NoError(t, k.AddVote(ctx, passingID, members[0], types.Yes))
+	require.NoError(t, k.AddVote(ctx, passingID, members[1], types.Yes))
+
+	failingID, err := k.StoreNewProposal(ctx, testPubProposal{Route: "failingroute"}, com.ID, ctx.BlockTime().Add(time.Hour))
+	require.NoError(t, err)
+	require.NoError(t, k.AddVote(ctx, failingID, members[0], types.Yes))
+	require.NoError(t, k.AddVote(ctx, failingID, members[1], types.Yes))
+
+	timeoutID, err := k.StoreNewProposal(ctx, testPubProposal{Route: "timeoutroute"}, com.ID, ctx.BlockTime().Add(-time.Hour))
+	require.NoError(t, err)
+
+	k.ProcessProposals(ctx)
+
+	require.True(t, failingHandlerCalled, "failingroute's handler should have been invoked by ProcessProposals")
+
+	_, found := k.GetProposal(ctx, passingID)
+	require.False(t, found, "a passed proposal should be removed from the store")
+	_, found = k.GetProposal(ctx, failingID)
+	require.False(t, found, "a proposal whose handler fails should still be removed from the store")
+	_, found = k.GetProposal(ctx, timeoutID)
+	require.False(t, found, "a timed out proposal should be removed from the store")
+
+	statuses := closeEvents(ctx.EventManager().Events())
+	require.Equal(t, types.AttributeValueProposalPassed, statuses[fmt.Sprintf("%d", passingID)])
+	require.Equal(t, types.AttributeValueProposalEnactFailed, statuses[fmt.Sprintf("%d", failingID)])
+	require.Equal(t, types.AttributeValueProposalTimeout, statuses[fmt.Sprintf("%d", timeoutID)])
+}
@@ -0,0 +1,34 @@
+package types
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+)
+
+// ModuleCdc is the codec used by the committee module for amino (de)serialization. It is initialized with only the
+// module's own types (via RegisterCodec) and the crypto types, then sealed, so PubProposal and Permission
+// implementations defined outside this module are not registered on it.
+var ModuleCdc *codec.Codec
+
+// RegisterCodec registers the committee module's interfaces and concrete types for amino encoding
+func RegisterCodec(cdc *codec.Codec) {
+	cdc.RegisterInterface((*PubProposal)(nil), nil)
+	cdc.RegisterInterface((*Committee)(nil), nil)
+	cdc.RegisterInterface((*Permission)(nil), nil)
+
+	cdc.RegisterConcrete(MsgSubmitProposal{}, "committee/MsgSubmitProposal", nil)
+	cdc.RegisterConcrete(MsgVote{}, "committee/MsgVote", nil)
+
+	cdc.RegisterConcrete(MemberCommittee{}, "committee/MemberCommittee", nil)
+	cdc.RegisterConcrete(TokenCommittee{}, "committee/TokenCommittee", nil)
+
+	cdc.RegisterConcrete(GodPermission{}, "committee/GodPermission", nil)
+	cdc.RegisterConcrete(SimpleParamChangePermission{}, "committee/SimpleParamChangePermission", nil)
+	cdc.RegisterConcrete(SubParamChangePermission{}, "committee/SubParamChangePermission", nil)
+}
+
+func init() {
+	ModuleCdc = codec.New()
+	RegisterCodec(ModuleCdc)
+	codec.RegisterCrypto(ModuleCdc)
+	ModuleCdc.Seal()
+}
@@ -0,0 +1,183 @@
+package types
+
+import (
+	"fmt"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Committee is the interface implemented by the different kinds of committee that can submit and vote on proposals.
+// MemberCommittee tallies votes using a simple majority of its members; TokenCommittee tallies votes by weighting
+// them with each voter's staked tokens in a configurable denom.
+type Committee interface {
+	GetID() uint64
+	GetDescription() string
+	GetMembers() []sdk.AccAddress
+	HasMember(addr sdk.AccAddress) bool
+	GetPermissions() []Permission
+	GetProposalDuration() time.Duration
+	GetVoteThreshold() sdk.Dec
+	Validate() error
+	String() string
+}
+
+// BaseCommittee holds the fields and behaviour common to all committee types.
+type BaseCommittee struct {
+	ID               uint64           `json:"id" yaml:"id"`
+	Description      string           `json:"description" yaml:"description"`
+	Members          []sdk.AccAddress `json:"members" yaml:"members"`
+	Permissions      []Permission     `json:"permissions" yaml:"permissions"`
+	VoteThreshold    sdk.Dec          `json:"vote_threshold" yaml:"vote_threshold"`
+	ProposalDuration time.Duration    `json:"proposal_duration" yaml:"proposal_duration"`
+}
+
+// GetID returns the ID of the committee
+func (c BaseCommittee) GetID() uint64 { return c.ID }
+
+// GetDescription returns the description of the committee
+func (c BaseCommittee) GetDescription() string { return c.Description }
+
+// GetMembers returns the addresses of the committee's members
+func (c BaseCommittee) GetMembers() []sdk.AccAddress { return c.Members }
+
+// HasMember returns true if the given address belongs to a member of the committee
+func (c BaseCommittee) HasMember(addr sdk.AccAddress) bool {
+	for _, m := range c.Members {
+		if m.Equals(addr) {
+			return true
+		}
+	}
+	return false
+}
+
+// GetPermissions returns the committee's permissions. A committee may submit a pub proposal if any one of its
+// permissions allows it.
+func (c BaseCommittee) GetPermissions() []Permission { return c.Permissions }
+
+// GetProposalDuration returns the duration proposals submitted to this committee remain open for voting
+func (c BaseCommittee) GetProposalDuration() time.Duration { return c.ProposalDuration }
+
+// GetVoteThreshold returns the fraction of votes needed for a proposal to pass
+func (c BaseCommittee) GetVoteThreshold() sdk.Dec { return c.VoteThreshold }
+
+// Validate performs basic validity checks on the fields shared by all committee types
+func (c BaseCommittee) Validate() error {
+	if c.ID == 0 {
+		return fmt.Errorf("committee ID cannot be 0")
+	}
+	if len(c.Members) == 0 {
+		return fmt.Errorf("committee %d must have at least one member", c.ID)
+	}
+	for _, m := range c.Members {
+		if m.Empty() {
+			return fmt.Errorf("committee %d cannot have an empty member address", c.ID)
+		}
+	}
+	if c.VoteThreshold.IsNil() || c.VoteThreshold.LTE(sdk.ZeroDec()) || c.VoteThreshold.GT(sdk.OneDec()) {
+		return fmt.Errorf("invalid vote threshold (%s) for committee %d, must be in (0, 1]", c.VoteThreshold, c.ID)
+	}
+	if c.ProposalDuration < 0 {
+		return fmt.Errorf("invalid proposal duration (%s) for committee %d, cannot be negative", c.ProposalDuration, c.ID)
+	}
+	for _, p := range c.Permissions {
+		if p == nil {
+			return fmt.Errorf("committee %d cannot have a nil permission", c.ID)
+		}
+	}
+	return nil
+}
+
+// MemberCommittee is a committee whose proposals are decided by a simple majority of its members.
+type MemberCommittee struct {
+	BaseCommittee `json:"base_committee" yaml:"base_committee"`
+}
+
+// NewMemberCommittee instantiates a new MemberCommittee
+func NewMemberCommittee(id uint64, description string, members []sdk.AccAddress, permissions []Permission, threshold sdk.Dec, duration time.Duration) MemberCommittee {
+	return MemberCommittee{
+		BaseCommittee: BaseCommittee{
+			ID:               id,
+			Description:      description,
+			Members:          members,
+			Permissions:      permissions,
+			VoteThreshold:    threshold,
+			ProposalDuration: duration,
+		},
+	}
+}
+
+// String implements fmt.Stringer
+func (c MemberCommittee) String() string {
+	return fmt.Sprintf(`Member Committee %d:
+  Description:       %s
+  Members:            %s
+  Permissions:        %s
+  Vote Threshold:     %s
+  Proposal Duration:  %s`, c.ID, c.Description, c.Members, c.Permissions, c.VoteThreshold, c.ProposalDuration)
+}
+
+// TokenCommittee is a committee whose proposals are decided by weighting votes by each voter's staked tokens in
+// TallyDenom, subject to a minimum Quorum of the tally denom's total supply participating in the vote.
+type TokenCommittee struct {
+	BaseCommittee `json:"base_committee" yaml:"base_committee"`
+
+	Quorum     sdk.Dec `json:"quorum" yaml:"quorum"`
+	TallyDenom string  `json:"tally_denom" yaml:"tally_denom"`
+}
+
+// NewTokenCommittee instantiates a new TokenCommittee
+func NewTokenCommittee(id uint64, description string, members []sdk.AccAddress, permissions []Permission, threshold sdk.Dec, duration time.Duration, quorum sdk.Dec, tallyDenom string) TokenCommittee {
+	return TokenCommittee{
+		BaseCommittee: BaseCommittee{
+			ID:               id,
+			Description:      description,
+			Members:          members,
+			Permissions:      permissions,
+			VoteThreshold:    threshold,
+			ProposalDuration: duration,
+		},
+		Quorum:     quorum,
+		TallyDenom: tallyDenom,
+	}
+}
+
+// Validate performs basic validity checks on the committee, including fields specific to token committees.
+// Note it cannot reject TallyDenom matching the staking bond denom as that requires access to the staking keeper;
+// Keeper.SetCommittee rejects such a committee before it is ever written to the store.
+func (c TokenCommittee) Validate() error {
+	if err := c.BaseCommittee.Validate(); err != nil {
+		return err
+	}
+	if c.Quorum.IsNil() || c.Quorum.LT(sdk.ZeroDec()) || c.Quorum.GT(sdk.OneDec()) {
+		return fmt.Errorf("invalid quorum (%s) for committee %d, must be in [0, 1]", c.Quorum, c.ID)
+	}
+	if err := sdk.ValidateDenom(c.TallyDenom); err != nil {
+		return fmt.Errorf("invalid tally denom for committee %d: %w", c.ID, err)
+	}
+	return nil
+}
+
+// String implements fmt.Stringer
+func (c TokenCommittee) String() string {
+	return fmt.Sprintf(`Token Committee %d:
+  Description:       %s
+  Members:            %s
+  Permissions:        %s
+  Vote Threshold:     %s
+  Quorum:             %s
+  Tally Denom:        %s
+  Proposal Duration:  %s`, c.ID, c.Description, c.Members, c.Permissions, c.VoteThreshold, c.Quorum, c.TallyDenom, c.ProposalDuration)
+}
+
+// Committees is a slice of Committee
+type Committees []Committee
+
+// String implements fmt.Stringer
+func (cs Committees) String() string {
+	out := fmt.Sprintf("%d committees:\n", len(cs))
+	for _, c := range cs {
+		out += fmt.Sprintf("%s\n", c)
+	}
+	return out
+}
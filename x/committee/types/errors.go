@@ -0,0 +1,56 @@
+package types
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// DefaultCodespace is the codespace for the committee module
+const DefaultCodespace sdk.CodespaceType = ModuleName
+
+// Error codes for the committee module
+const (
+	CodeUnknownCommittee sdk.CodeType = iota + 1
+	CodeUnknownProposal
+	CodeInvalidPubProposal
+	CodeNoProposalHandlerExists
+	CodeProposalExpired
+	CodeInvalidVoteType
+	CodeInvalidTallyDenom
+)
+
+// ErrUnknownCommittee returns an error when a committee with the given ID cannot be found
+func ErrUnknownCommittee(codespace sdk.CodespaceType, committeeID uint64) sdk.Error {
+	return sdk.NewError(codespace, CodeUnknownCommittee, "committee with ID %d not found", committeeID)
+}
+
+// ErrUnknownProposal returns an error when a proposal with the given ID cannot be found
+func ErrUnknownProposal(codespace sdk.CodespaceType, proposalID uint64) sdk.Error {
+	return sdk.NewError(codespace, CodeUnknownProposal, "proposal with ID %d not found", proposalID)
+}
+
+// ErrInvalidPubProposal returns an error for an invalid pub proposal, taking an arbitrary reason string
+func ErrInvalidPubProposal(codespace sdk.CodespaceType, reason string) sdk.Error {
+	return sdk.NewError(codespace, CodeInvalidPubProposal, "invalid pub proposal: %s", reason)
+}
+
+// ErrNoProposalHandlerExists returns an error when there is no registered handler for a proposal's route
+func ErrNoProposalHandlerExists(codespace sdk.CodespaceType, action interface{}) sdk.Error {
+	return sdk.NewError(codespace, CodeNoProposalHandlerExists, "%T does not have a corresponding handler", action)
+}
+
+// ErrProposalExpired returns an error when a proposal's deadline has already passed
+func ErrProposalExpired(codespace sdk.CodespaceType, blockTime, deadline time.Time) sdk.Error {
+	return sdk.NewError(codespace, CodeProposalExpired, "proposal deadline '%s' is before block time '%s'", deadline, blockTime)
+}
+
+// ErrInvalidVoteType returns an error when a vote is submitted with an unrecognized vote type
+func ErrInvalidVoteType(codespace sdk.CodespaceType, voteType interface{}) sdk.Error {
+	return sdk.NewError(codespace, CodeInvalidVoteType, "invalid vote type: %v", voteType)
+}
+
+// ErrInvalidTallyDenom returns an error when a token committee's tally denom is not permitted
+func ErrInvalidTallyDenom(codespace sdk.CodespaceType, denom string) sdk.Error {
+	return sdk.NewError(codespace, CodeInvalidTallyDenom, "invalid tally denom '%s': cannot use the staking bond denom", denom)
+}
@@ -0,0 +1,23 @@
+package types
+
+// Event types and attributes for the committee module
+const (
+	EventTypeProposalSubmit = "proposal_submit"
+	EventTypeProposalVote   = "proposal_vote"
+	EventTypeProposalClose  = "proposal_close"
+
+	AttributeKeyCommitteeID          = "committee_id"
+	AttributeKeyProposalID           = "proposal_id"
+	AttributeKeyVoter                = "voter"
+	AttributeKeyVoteType             = "vote_type"
+	AttributeKeyProposalCloseStatus  = "proposal_close_status"
+	AttributeKeyProposalTallyYes     = "proposal_tally_yes"
+	AttributeKeyProposalTallyNo      = "proposal_tally_no"
+	AttributeKeyProposalTallyAbstain = "proposal_tally_abstain"
+
+	AttributeValueProposalPassed      = "proposal_passed"
+	AttributeValueProposalTimeout     = "proposal_timeout"
+	AttributeValueProposalEnactFailed = "proposal_enact_failed"
+
+	AttributeValueCategory = ModuleName
+)
@@ -0,0 +1,24 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramsubspace "github.com/cosmos/cosmos-sdk/x/params/subspace"
+	supplyexported "github.com/cosmos/cosmos-sdk/x/supply/exported"
+)
+
+// StakingKeeper defines the staking functionality needed by the committee module to tally token committee votes.
+// GetDelegatorBonded returns the sum of a delegator's bonded and delegated stake, denominated in denom.
+type StakingKeeper interface {
+	BondDenom(ctx sdk.Context) string
+	GetDelegatorBonded(ctx sdk.Context, delegator sdk.AccAddress, denom string) sdk.Int
+}
+
+// SupplyKeeper defines the supply functionality needed by the committee module to look up a tally denom's total supply.
+type SupplyKeeper interface {
+	GetSupply(ctx sdk.Context) supplyexported.SupplyI
+}
+
+// ParamKeeper defines the params functionality needed by permissions to validate param change proposals
+type ParamKeeper interface {
+	GetSubspace(s string) (paramsubspace.Subspace, bool)
+}
@@ -0,0 +1,66 @@
+package types
+
+import "fmt"
+
+// DefaultNextProposalID is the default value for the next available proposal ID in a fresh genesis state
+const DefaultNextProposalID uint64 = 1
+
+// GenesisState is the state that must be provided when the committee module starts, whether at genesis or after
+// an upgrade.
+type GenesisState struct {
+	NextProposalID uint64     `json:"next_proposal_id" yaml:"next_proposal_id"`
+	Committees     Committees `json:"committees" yaml:"committees"`
+	Proposals      Proposals  `json:"proposals" yaml:"proposals"`
+	Votes          Votes      `json:"votes" yaml:"votes"`
+}
+
+// NewGenesisState returns a new genesis state object for the committee module
+func NewGenesisState(nextProposalID uint64, committees Committees, proposals Proposals, votes Votes) GenesisState {
+	return GenesisState{
+		NextProposalID: nextProposalID,
+		Committees:     committees,
+		Proposals:      proposals,
+		Votes:          votes,
+	}
+}
+
+// DefaultGenesisState returns the default genesis state for the committee module, with no committees and no
+// open proposals.
+func DefaultGenesisState() GenesisState {
+	return NewGenesisState(DefaultNextProposalID, Committees{}, Proposals{}, Votes{})
+}
+
+// Validate performs basic validity checks of the genesis state, returning an error for any failures.
+func (gs GenesisState) Validate() error {
+	committeeIDs := make(map[uint64]bool)
+	for _, com := range gs.Committees {
+		if err := com.Validate(); err != nil {
+			return err
+		}
+		if committeeIDs[com.GetID()] {
+			return fmt.Errorf("duplicate committee ID found in genesis state: %d", com.GetID())
+		}
+		committeeIDs[com.GetID()] = true
+	}
+
+	proposalIDs := make(map[uint64]bool)
+	for _, p := range gs.Proposals {
+		if !committeeIDs[p.CommitteeID] {
+			return fmt.Errorf("proposal %d references unknown committee %d", p.ID, p.CommitteeID)
+		}
+		if p.ID >= gs.NextProposalID {
+			return fmt.Errorf("proposal ID %d is >= next proposal ID %d", p.ID, gs.NextProposalID)
+		}
+		proposalIDs[p.ID] = true
+	}
+
+	for _, v := range gs.Votes {
+		if err := v.Validate(); err != nil {
+			return err
+		}
+		if !proposalIDs[v.ProposalID] {
+			return fmt.Errorf("vote references unknown proposal %d", v.ProposalID)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,46 @@
+package types
+
+const (
+	// ModuleName is the name of the committee module
+	ModuleName = "committee"
+
+	// StoreKey is the store key string for the committee module
+	StoreKey = ModuleName
+
+	// RouterKey is the message route for the committee module
+	RouterKey = ModuleName
+
+	// QuerierRoute is the querier route for the committee module
+	QuerierRoute = ModuleName
+
+	// DefaultParamspace default namespace for params
+	DefaultParamspace = ModuleName
+)
+
+// Key prefixes for the committee module's store
+var (
+	CommitteeKeyPrefix = []byte{0x00} // prefix for keys that store committees
+	ProposalKeyPrefix  = []byte{0x01} // prefix for keys that store proposals
+	VoteKeyPrefix      = []byte{0x02} // prefix for keys that store votes
+	NextProposalIDKey  = []byte{0x03} // key for the next proposal id
+)
+
+// GetCommitteeKey returns the key for a committee
+func GetCommitteeKey(committeeID uint64) []byte {
+	return append(CommitteeKeyPrefix, Uint64ToBytes(committeeID)...)
+}
+
+// GetProposalKey returns the key for a proposal
+func GetProposalKey(proposalID uint64) []byte {
+	return append(ProposalKeyPrefix, Uint64ToBytes(proposalID)...)
+}
+
+// GetVoteKey returns the key for a vote on a proposal by a particular voter
+func GetVoteKey(proposalID uint64, voter []byte) []byte {
+	return append(GetVoteKeyPrefix(proposalID), voter...)
+}
+
+// GetVoteKeyPrefix returns the prefix key for all votes on a proposal
+func GetVoteKeyPrefix(proposalID uint64) []byte {
+	return append(VoteKeyPrefix, Uint64ToBytes(proposalID)...)
+}
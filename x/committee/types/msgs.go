@@ -0,0 +1,95 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// MsgSubmitProposal is submitted by a committee member to propose a change of state that the committee can then
+// vote on. This holds for token committees too: only voting on their proposals is open to any account.
+type MsgSubmitProposal struct {
+	PubProposal PubProposal    `json:"pub_proposal" yaml:"pub_proposal"`
+	Proposer    sdk.AccAddress `json:"proposer" yaml:"proposer"`
+	CommitteeID uint64         `json:"committee_id" yaml:"committee_id"`
+}
+
+// NewMsgSubmitProposal instantiates a new MsgSubmitProposal
+func NewMsgSubmitProposal(pubProposal PubProposal, proposer sdk.AccAddress, committeeID uint64) MsgSubmitProposal {
+	return MsgSubmitProposal{
+		PubProposal: pubProposal,
+		Proposer:    proposer,
+		CommitteeID: committeeID,
+	}
+}
+
+// Route implements sdk.Msg
+func (msg MsgSubmitProposal) Route() string { return RouterKey }
+
+// Type implements sdk.Msg
+func (msg MsgSubmitProposal) Type() string { return "committee_submit_proposal" }
+
+// ValidateBasic implements sdk.Msg
+func (msg MsgSubmitProposal) ValidateBasic() sdk.Error {
+	if msg.Proposer.Empty() {
+		return sdk.ErrInvalidAddress("proposer address cannot be empty")
+	}
+	if msg.PubProposal == nil {
+		return ErrInvalidPubProposal(DefaultCodespace, "pub proposal cannot be nil")
+	}
+	if err := msg.PubProposal.ValidateBasic(); err != nil {
+		return err
+	}
+	return nil
+}
+
+// GetSignBytes implements sdk.Msg
+func (msg MsgSubmitProposal) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners implements sdk.Msg
+func (msg MsgSubmitProposal) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Proposer}
+}
+
+// MsgVote is submitted by an account to vote on an open proposal.
+type MsgVote struct {
+	ProposalID uint64         `json:"proposal_id" yaml:"proposal_id"`
+	Voter      sdk.AccAddress `json:"voter" yaml:"voter"`
+	VoteType   VoteType       `json:"vote_type" yaml:"vote_type"`
+}
+
+// NewMsgVote instantiates a new MsgVote
+func NewMsgVote(voter sdk.AccAddress, proposalID uint64, voteType VoteType) MsgVote {
+	return MsgVote{
+		ProposalID: proposalID,
+		Voter:      voter,
+		VoteType:   voteType,
+	}
+}
+
+// Route implements sdk.Msg
+func (msg MsgVote) Route() string { return RouterKey }
+
+// Type implements sdk.Msg
+func (msg MsgVote) Type() string { return "committee_vote" }
+
+// ValidateBasic implements sdk.Msg
+func (msg MsgVote) ValidateBasic() sdk.Error {
+	if msg.Voter.Empty() {
+		return sdk.ErrInvalidAddress("voter address cannot be empty")
+	}
+	if !msg.VoteType.Valid() {
+		return ErrInvalidVoteType(DefaultCodespace, msg.VoteType)
+	}
+	return nil
+}
+
+// GetSignBytes implements sdk.Msg
+func (msg MsgVote) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners implements sdk.Msg
+func (msg MsgVote) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Voter}
+}
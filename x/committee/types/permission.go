@@ -0,0 +1,245 @@
+package types
+
+import (
+	"bytes"
+	"encoding/json"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	params "github.com/cosmos/cosmos-sdk/x/params"
+)
+
+// Permission is the interface that committee permissions must fulfil. A committee can hold several permissions;
+// it may submit a PubProposal if any one of them allows it.
+type Permission interface {
+	Allows(ctx sdk.Context, appCodec *codec.Codec, paramKeeper ParamKeeper, pubProposal PubProposal) bool
+}
+
+// GodPermission allows a committee to submit any pub proposal.
+type GodPermission struct{}
+
+// Allows implements Permission
+func (GodPermission) Allows(sdk.Context, *codec.Codec, ParamKeeper, PubProposal) bool {
+	return true
+}
+
+// subspaceHasKey returns true if paramKeeper has a registered subspace matching subspaceName, and that subspace
+// has a param registered under key. It guards against trusting a proposal's raw (subspace, key, value) triple
+// before the keeper itself has ever heard of that key.
+func subspaceHasKey(ctx sdk.Context, paramKeeper ParamKeeper, subspaceName, key string) bool {
+	subspace, found := paramKeeper.GetSubspace(subspaceName)
+	if !found {
+		return false
+	}
+	return subspace.Has(ctx, []byte(key))
+}
+
+// AllowedParam identifies a single (subspace, key) param that a permission is allowed to change.
+type AllowedParam struct {
+	Subspace string `json:"subspace" yaml:"subspace"`
+	Key      string `json:"key" yaml:"key"`
+}
+
+// AllowedParams is a slice of AllowedParam
+type AllowedParams []AllowedParam
+
+// Allows returns true if change is in the list of allowed params, and the param keeper confirms the (subspace,
+// key) pair is actually a registered param.
+func (ap AllowedParams) Allows(ctx sdk.Context, paramKeeper ParamKeeper, change params.ParamChange) bool {
+	for _, p := range ap {
+		if p.Subspace == change.Subspace && p.Key == change.Key {
+			return subspaceHasKey(ctx, paramKeeper, change.Subspace, change.Key)
+		}
+	}
+	return false
+}
+
+// SimpleParamChangePermission allows a committee to submit param change proposals that only alter a whitelisted
+// set of (subspace, key) params, regardless of the new value.
+type SimpleParamChangePermission struct {
+	AllowedParams AllowedParams `json:"allowed_params" yaml:"allowed_params"`
+}
+
+// Allows implements Permission
+func (perm SimpleParamChangePermission) Allows(ctx sdk.Context, _ *codec.Codec, paramKeeper ParamKeeper, pubProposal PubProposal) bool {
+	proposal, ok := pubProposal.(params.ParameterChangeProposal)
+	if !ok {
+		return false
+	}
+	for _, change := range proposal.Changes {
+		if !perm.AllowedParams.Allows(ctx, paramKeeper, change) {
+			return false
+		}
+	}
+	return true
+}
+
+// AllowedRange identifies a (subspace, key) param whose new value must decode to a sdk.Dec within [LowerBound, UpperBound].
+type AllowedRange struct {
+	Subspace   string  `json:"subspace" yaml:"subspace"`
+	Key        string  `json:"key" yaml:"key"`
+	LowerBound sdk.Dec `json:"lower_bound" yaml:"lower_bound"`
+	UpperBound sdk.Dec `json:"upper_bound" yaml:"upper_bound"`
+}
+
+// Allows returns true if change matches this range's (subspace, key), the param keeper confirms that key is
+// registered, and the new value decodes to a sdk.Dec within [LowerBound, UpperBound].
+func (ar AllowedRange) Allows(ctx sdk.Context, paramKeeper ParamKeeper, change params.ParamChange) bool {
+	if ar.Subspace != change.Subspace || ar.Key != change.Key {
+		return false
+	}
+	if !subspaceHasKey(ctx, paramKeeper, change.Subspace, change.Key) {
+		return false
+	}
+	var value sdk.Dec
+	if err := json.Unmarshal([]byte(change.Value), &value); err != nil {
+		return false
+	}
+	return value.GTE(ar.LowerBound) && value.LTE(ar.UpperBound)
+}
+
+// AllowedRanges is a slice of AllowedRange
+type AllowedRanges []AllowedRange
+
+// Allows returns true if change matches one of the ranges' (subspace, key) and falls within its bounds
+func (ars AllowedRanges) Allows(ctx sdk.Context, paramKeeper ParamKeeper, change params.ParamChange) bool {
+	for _, ar := range ars {
+		if ar.Subspace == change.Subspace && ar.Key == change.Key {
+			return ar.Allows(ctx, paramKeeper, change)
+		}
+	}
+	return false
+}
+
+// collateralListEntry is one entry of a decoded collateral-list param (eg cdp's CollateralParams): denom
+// identifies it, and raw retains its full, unmodified JSON encoding so it can be compared for equality.
+type collateralListEntry struct {
+	denom string
+	raw   json.RawMessage
+}
+
+// decodeCollateralList decodes a collateral-list param's JSON-encoded value into its entries, keeping each
+// entry's raw JSON around unmodified so equality checks aren't at the mercy of a round-tripped re-encoding.
+func decodeCollateralList(value []byte) ([]collateralListEntry, bool) {
+	var rawEntries []json.RawMessage
+	if err := json.Unmarshal(value, &rawEntries); err != nil {
+		return nil, false
+	}
+	entries := make([]collateralListEntry, len(rawEntries))
+	for i, raw := range rawEntries {
+		var denomOnly struct {
+			Denom string `json:"denom"`
+		}
+		if err := json.Unmarshal(raw, &denomOnly); err != nil {
+			return nil, false
+		}
+		entries[i] = collateralListEntry{denom: denomOnly.Denom, raw: raw}
+	}
+	return entries, true
+}
+
+// AllowedCollateralParam identifies a (subspace, key) param holding a list of per-collateral entries (eg cdp's
+// CollateralParams), and restricts changes to that list so that every entry for a denom not in Denoms is left
+// byte-for-byte unchanged from the currently stored value. This lets a committee be scoped to add, remove, or
+// edit entries for a whitelisted set of collateral types, without being able to touch, or silently drop, any
+// other collateral type's entry in that same list - a ParamChange's Value fully replaces the stored list, so
+// merely checking the new value's entries (and not the old) would let a committee delete anything it pleased.
+type AllowedCollateralParam struct {
+	Subspace string   `json:"subspace" yaml:"subspace"`
+	Key      string   `json:"key" yaml:"key"`
+	Denoms   []string `json:"denoms" yaml:"denoms"`
+}
+
+// Allows returns true if change matches this entry's (subspace, key), the param keeper confirms that key is
+// registered, both the new and currently stored values decode into lists of denom-identified entries, and every
+// entry in the new value for a denom not in Denoms is present, byte-for-byte unchanged, in the current value.
+func (acp AllowedCollateralParam) Allows(ctx sdk.Context, paramKeeper ParamKeeper, change params.ParamChange) bool {
+	if acp.Subspace != change.Subspace || acp.Key != change.Key {
+		return false
+	}
+	subspace, found := paramKeeper.GetSubspace(acp.Subspace)
+	if !found || !subspace.Has(ctx, []byte(acp.Key)) {
+		return false
+	}
+
+	newEntries, ok := decodeCollateralList([]byte(change.Value))
+	if !ok {
+		return false
+	}
+	currentEntries, ok := decodeCollateralList(subspace.GetRaw(ctx, []byte(acp.Key)))
+	if !ok {
+		return false
+	}
+
+	allowedDenoms := make(map[string]bool, len(acp.Denoms))
+	for _, d := range acp.Denoms {
+		allowedDenoms[d] = true
+	}
+
+	currentNonWhitelisted := make(map[string]json.RawMessage, len(currentEntries))
+	for _, e := range currentEntries {
+		if !allowedDenoms[e.denom] {
+			currentNonWhitelisted[e.denom] = e.raw
+		}
+	}
+
+	seen := make(map[string]bool, len(currentNonWhitelisted))
+	for _, e := range newEntries {
+		if allowedDenoms[e.denom] {
+			continue
+		}
+		current, found := currentNonWhitelisted[e.denom]
+		if !found || seen[e.denom] || !bytes.Equal(current, e.raw) {
+			return false // a non-whitelisted entry was added, modified, or duplicated
+		}
+		seen[e.denom] = true
+	}
+	return len(seen) == len(currentNonWhitelisted) // every non-whitelisted entry must still be present
+}
+
+// AllowedCollateralParams is a slice of AllowedCollateralParam
+type AllowedCollateralParams []AllowedCollateralParam
+
+// Allows returns true if change matches one of the collateral params' (subspace, key) and that collateral param
+// allows it, ie every entry in its new value for a non-whitelisted denom is left unchanged
+func (acps AllowedCollateralParams) Allows(ctx sdk.Context, paramKeeper ParamKeeper, change params.ParamChange) bool {
+	for _, acp := range acps {
+		if acp.Subspace == change.Subspace && acp.Key == change.Key {
+			return acp.Allows(ctx, paramKeeper, change)
+		}
+	}
+	return false
+}
+
+// SubParamChangePermission allows a committee to submit param change proposals that alter a whitelisted set of
+// (subspace, key) params regardless of value, or alter a separate set of (subspace, key) params subject to
+// per-key constraints: a numeric value within an allowed range, or, for list-valued params, a whitelisted set of
+// collateral denoms. This lets a committee be authorized to, say, tweak a collateral type's LiquidationRatio
+// within [1.25, 3.0], or add/edit entries for a whitelisted set of collateral types, without being able to change
+// anything else about the market (such as removing a collateral type it isn't scoped to).
+type SubParamChangePermission struct {
+	AllowedParams           AllowedParams           `json:"allowed_params" yaml:"allowed_params"`
+	AllowedRanges           AllowedRanges           `json:"allowed_ranges" yaml:"allowed_ranges"`
+	AllowedCollateralParams AllowedCollateralParams `json:"allowed_collateral_params" yaml:"allowed_collateral_params"`
+}
+
+// Allows implements Permission
+func (perm SubParamChangePermission) Allows(ctx sdk.Context, _ *codec.Codec, paramKeeper ParamKeeper, pubProposal PubProposal) bool {
+	proposal, ok := pubProposal.(params.ParameterChangeProposal)
+	if !ok {
+		return false
+	}
+	for _, change := range proposal.Changes {
+		if perm.AllowedParams.Allows(ctx, paramKeeper, change) {
+			continue
+		}
+		if perm.AllowedRanges.Allows(ctx, paramKeeper, change) {
+			continue
+		}
+		if perm.AllowedCollateralParams.Allows(ctx, paramKeeper, change) {
+			continue
+		}
+		return false
+	}
+	return true
+}
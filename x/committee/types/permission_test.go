@@ -0,0 +1,279 @@
+package types_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/store"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	params "github.com/cosmos/cosmos-sdk/x/params"
+	paramsubspace "github.com/cosmos/cosmos-sdk/x/params/subspace"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/libs/log"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/kava-labs/kava/x/committee/types"
+)
+
+const (
+	testSubspace        = "test"
+	testRatioKey        = "LiquidationRatio"
+	testCollateralsKey  = "CollateralParams"
+	testUnregisteredKey = "NotRegistered"
+)
+
+// testCollateralParam stands in for a module's per-collateral param entry (eg cdp's CollateralParam): Denom
+// identifies it, and Value is just some other field so tests can tell a modified entry from an untouched one.
+type testCollateralParam struct {
+	Denom string `json:"denom" yaml:"denom"`
+	Value int64  `json:"value,string" yaml:"value"` // amino JSON, which params are stored as, encodes int64 as a string
+}
+
+// testParams stands in for a module's Params type, giving permission tests a simple param and a collateral-list
+// param to register and seed in a real subspace.
+type testParams struct {
+	LiquidationRatio sdk.Dec               `json:"liquidation_ratio" yaml:"liquidation_ratio"`
+	CollateralParams []testCollateralParam `json:"collateral_params" yaml:"collateral_params"`
+}
+
+// ParamSetPairs implements params.ParamSet
+func (p *testParams) ParamSetPairs() paramsubspace.ParamSetPairs {
+	return paramsubspace.ParamSetPairs{
+		{Key: []byte(testRatioKey), Value: &p.LiquidationRatio, ValidatorFn: func(interface{}) error { return nil }},
+		{Key: []byte(testCollateralsKey), Value: &p.CollateralParams, ValidatorFn: func(interface{}) error { return nil }},
+	}
+}
+
+// setupSubspace creates a real params.Keeper backed by an in-memory store, registers a "test" subspace with a key
+// table for testParams, and seeds it with initialParams. Permission checks must be tested against a real Subspace
+// because mockParamKeeper (in x/committee/keeper's tests) reports every subspace as unregistered and so can never
+// exercise an allow path.
+func setupSubspace(t *testing.T, initialParams testParams) (sdk.Context, types.ParamKeeper) {
+	paramsKey := sdk.NewKVStoreKey(params.StoreKey)
+	tParamsKey := sdk.NewKVStoreKey(params.TStoreKey)
+
+	ms := store.NewCommitMultiStore(dbm.NewMemDB())
+	ms.MountStoreWithDB(paramsKey, sdk.StoreTypeIAVL, nil)
+	ms.MountStoreWithDB(tParamsKey, sdk.StoreTypeTransient, nil)
+	require.NoError(t, ms.LoadLatestVersion())
+
+	ctx := sdk.NewContext(ms, abci.Header{}, false, log.NewNopLogger())
+
+	cdc := codec.New()
+	codec.RegisterCrypto(cdc)
+
+	paramKeeper := params.NewKeeper(cdc, paramsKey, tParamsKey)
+	subspace := paramKeeper.Subspace(testSubspace).WithKeyTable(params.NewKeyTable().RegisterParamSet(&testParams{}))
+	subspace.SetParamSet(ctx, &initialParams)
+
+	return ctx, paramKeeper
+}
+
+func TestAllowedParams_Allows(t *testing.T) {
+	ctx, paramKeeper := setupSubspace(t, testParams{LiquidationRatio: sdk.MustNewDecFromStr("1.5")})
+	allowed := types.AllowedParams{{Subspace: testSubspace, Key: testRatioKey}}
+
+	testCases := []struct {
+		name    string
+		change  params.ParamChange
+		allowed bool
+	}{
+		{"matching subspace and key", params.NewParamChange(testSubspace, testRatioKey, `"2.0"`), true},
+		{"non-matching key", params.NewParamChange(testSubspace, testCollateralsKey, `[]`), false},
+		{"non-matching subspace", params.NewParamChange("othersubspace", testRatioKey, `"2.0"`), false},
+		{"key not registered in the subspace", params.NewParamChange(testSubspace, testUnregisteredKey, `"2.0"`), false},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.allowed, allowed.Allows(ctx, paramKeeper, tc.change))
+		})
+	}
+}
+
+func TestAllowedRange_Allows(t *testing.T) {
+	ctx, paramKeeper := setupSubspace(t, testParams{LiquidationRatio: sdk.MustNewDecFromStr("1.5")})
+	ranges := types.AllowedRanges{{
+		Subspace:   testSubspace,
+		Key:        testRatioKey,
+		LowerBound: sdk.MustNewDecFromStr("1.0"),
+		UpperBound: sdk.MustNewDecFromStr("3.0"),
+	}}
+
+	testCases := []struct {
+		name    string
+		change  params.ParamChange
+		allowed bool
+	}{
+		{"value within range", params.NewParamChange(testSubspace, testRatioKey, `"2.0"`), true},
+		{"value at lower bound", params.NewParamChange(testSubspace, testRatioKey, `"1.0"`), true},
+		{"value at upper bound", params.NewParamChange(testSubspace, testRatioKey, `"3.0"`), true},
+		{"value below range", params.NewParamChange(testSubspace, testRatioKey, `"0.5"`), false},
+		{"value above range", params.NewParamChange(testSubspace, testRatioKey, `"3.5"`), false},
+		{"non-matching key", params.NewParamChange(testSubspace, testCollateralsKey, `"2.0"`), false},
+		{"key not registered in the subspace", params.NewParamChange(testSubspace, testUnregisteredKey, `"2.0"`), false},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.allowed, ranges.Allows(ctx, paramKeeper, tc.change))
+		})
+	}
+}
+
+func TestSimpleParamChangePermission_Allows(t *testing.T) {
+	ctx, paramKeeper := setupSubspace(t, testParams{LiquidationRatio: sdk.MustNewDecFromStr("1.5")})
+	perm := types.SimpleParamChangePermission{
+		AllowedParams: types.AllowedParams{{Subspace: testSubspace, Key: testRatioKey}},
+	}
+
+	allowedProposal := params.NewParameterChangeProposal(
+		"title", "description",
+		[]params.ParamChange{params.NewParamChange(testSubspace, testRatioKey, `"2.0"`)},
+	)
+	disallowedProposal := params.NewParameterChangeProposal(
+		"title", "description",
+		[]params.ParamChange{params.NewParamChange(testSubspace, testCollateralsKey, `[]`)},
+	)
+
+	require.True(t, perm.Allows(ctx, nil, paramKeeper, allowedProposal))
+	require.False(t, perm.Allows(ctx, nil, paramKeeper, disallowedProposal))
+	require.False(t, perm.Allows(ctx, nil, paramKeeper, testPubProposal{}))
+}
+
+// testPubProposal is a minimal types.PubProposal used to check that permissions expecting a
+// params.ParameterChangeProposal reject any other pub proposal type outright.
+type testPubProposal struct{}
+
+func (testPubProposal) GetTitle() string         { return "test proposal" }
+func (testPubProposal) GetDescription() string   { return "a proposal used for testing" }
+func (testPubProposal) ProposalRoute() string    { return "testroute" }
+func (testPubProposal) ValidateBasic() sdk.Error { return nil }
+
+// rawCollateralEntries returns the currently stored CollateralParams value decoded into its raw JSON elements, so
+// tests can build new values that copy some entries byte-for-byte and modify others.
+func rawCollateralEntries(t *testing.T, ctx sdk.Context, subspace paramsubspace.Subspace) map[string]json.RawMessage {
+	var rawEntries []json.RawMessage
+	require.NoError(t, json.Unmarshal(subspace.GetRaw(ctx, []byte(testCollateralsKey)), &rawEntries))
+
+	byDenom := make(map[string]json.RawMessage, len(rawEntries))
+	for _, raw := range rawEntries {
+		var entry testCollateralParam
+		require.NoError(t, json.Unmarshal(raw, &entry))
+		byDenom[entry.Denom] = raw
+	}
+	return byDenom
+}
+
+func mustMarshalCollaterals(t *testing.T, entries ...json.RawMessage) string {
+	value, err := json.Marshal(entries)
+	require.NoError(t, err)
+	return string(value)
+}
+
+func TestAllowedCollateralParam_Allows(t *testing.T) {
+	initialParams := testParams{
+		LiquidationRatio: sdk.MustNewDecFromStr("1.5"),
+		CollateralParams: []testCollateralParam{
+			{Denom: "btc", Value: 1},
+			{Denom: "busd", Value: 2},
+		},
+	}
+	ctx, paramKeeper := setupSubspace(t, initialParams)
+	subspace, found := paramKeeper.GetSubspace(testSubspace)
+	require.True(t, found)
+
+	acp := types.AllowedCollateralParam{Subspace: testSubspace, Key: testCollateralsKey, Denoms: []string{"busd", "usdx"}}
+
+	current := rawCollateralEntries(t, ctx, subspace)
+	newBusd, err := json.Marshal(testCollateralParam{Denom: "busd", Value: 99})
+	require.NoError(t, err)
+
+	testCases := []struct {
+		name    string
+		value   string
+		allowed bool
+	}{
+		{
+			name:    "whitelisted entry changed, other entry left byte-for-byte unchanged",
+			value:   mustMarshalCollaterals(t, current["btc"], newBusd),
+			allowed: true,
+		},
+		{
+			name:    "non-whitelisted entry deleted entirely",
+			value:   mustMarshalCollaterals(t, newBusd),
+			allowed: false, // regression check: this used to silently delete btc's config
+		},
+		{
+			name: "non-whitelisted entry modified",
+			value: mustMarshalCollaterals(t, func() json.RawMessage {
+				raw, err := json.Marshal(testCollateralParam{Denom: "btc", Value: 1234})
+				require.NoError(t, err)
+				return raw
+			}(), newBusd),
+			allowed: false,
+		},
+		{
+			name:    "whitelisted entry added",
+			value:   mustMarshalCollaterals(t, current["btc"], newBusd, []byte(`{"denom":"usdx","value":3}`)),
+			allowed: true,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			change := params.NewParamChange(testSubspace, testCollateralsKey, tc.value)
+			require.Equal(t, tc.allowed, acp.Allows(ctx, paramKeeper, change))
+		})
+	}
+}
+
+func TestSubParamChangePermission_Allows(t *testing.T) {
+	initialParams := testParams{
+		LiquidationRatio: sdk.MustNewDecFromStr("1.5"),
+		CollateralParams: []testCollateralParam{
+			{Denom: "btc", Value: 1},
+			{Denom: "busd", Value: 2},
+		},
+	}
+	ctx, paramKeeper := setupSubspace(t, initialParams)
+	subspace, found := paramKeeper.GetSubspace(testSubspace)
+	require.True(t, found)
+
+	perm := types.SubParamChangePermission{
+		AllowedRanges: types.AllowedRanges{{
+			Subspace:   testSubspace,
+			Key:        testRatioKey,
+			LowerBound: sdk.MustNewDecFromStr("1.0"),
+			UpperBound: sdk.MustNewDecFromStr("3.0"),
+		}},
+		AllowedCollateralParams: types.AllowedCollateralParams{
+			{Subspace: testSubspace, Key: testCollateralsKey, Denoms: []string{"busd"}},
+		},
+	}
+
+	current := rawCollateralEntries(t, ctx, subspace)
+	newBusd, err := json.Marshal(testCollateralParam{Denom: "busd", Value: 99})
+	require.NoError(t, err)
+
+	allowedProposal := params.NewParameterChangeProposal(
+		"title", "description",
+		[]params.ParamChange{
+			params.NewParamChange(testSubspace, testRatioKey, `"2.0"`),
+			params.NewParamChange(testSubspace, testCollateralsKey, mustMarshalCollaterals(t, current["btc"], newBusd)),
+		},
+	)
+	require.True(t, perm.Allows(ctx, nil, paramKeeper, allowedProposal))
+
+	outOfRangeProposal := params.NewParameterChangeProposal(
+		"title", "description",
+		[]params.ParamChange{params.NewParamChange(testSubspace, testRatioKey, `"5.0"`)},
+	)
+	require.False(t, perm.Allows(ctx, nil, paramKeeper, outOfRangeProposal))
+
+	deletesOtherCollateralProposal := params.NewParameterChangeProposal(
+		"title", "description",
+		[]params.ParamChange{params.NewParamChange(testSubspace, testCollateralsKey, mustMarshalCollaterals(t, newBusd))},
+	)
+	require.False(t, perm.Allows(ctx, nil, paramKeeper, deletesOtherCollateralProposal))
+}
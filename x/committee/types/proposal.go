@@ -0,0 +1,63 @@
+package types
+
+import (
+	"fmt"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// PubProposal is the interface that all proposals handled by committees must fulfil. It is pegged to the existing
+// gov.Content interface so that the same proposal types (eg param change proposals) can be submitted to either
+// the gov module or a committee.
+type PubProposal interface {
+	GetTitle() string
+	GetDescription() string
+	ProposalRoute() string
+	ValidateBasic() sdk.Error
+}
+
+// Proposal is an internally recorded proposal, wrapping a PubProposal with committee specific metadata.
+type Proposal struct {
+	PubProposal `json:"pub_proposal" yaml:"pub_proposal"`
+
+	ID          uint64    `json:"id" yaml:"id"`
+	CommitteeID uint64    `json:"committee_id" yaml:"committee_id"`
+	Deadline    time.Time `json:"deadline" yaml:"deadline"`
+}
+
+// NewProposal instantiates a new Proposal
+func NewProposal(pubProposal PubProposal, id uint64, committeeID uint64, deadline time.Time) Proposal {
+	return Proposal{
+		PubProposal: pubProposal,
+		ID:          id,
+		CommitteeID: committeeID,
+		Deadline:    deadline,
+	}
+}
+
+// HasExpiredBy returns whether the proposal will have expired by a certain time.
+// All votes must be cast before deadline, but proposals can be enacted on or after the deadline.
+func (p Proposal) HasExpiredBy(blockTime time.Time) bool {
+	return !blockTime.Before(p.Deadline)
+}
+
+// String implements fmt.Stringer
+func (p Proposal) String() string {
+	return fmt.Sprintf(`Proposal %d:
+  Committee ID:   %d
+  Deadline:       %s
+  Content:        %s`, p.ID, p.CommitteeID, p.Deadline, p.PubProposal)
+}
+
+// Proposals is a slice of Proposal
+type Proposals []Proposal
+
+// String implements fmt.Stringer
+func (ps Proposals) String() string {
+	out := fmt.Sprintf("%d proposals:\n", len(ps))
+	for _, p := range ps {
+		out += fmt.Sprintf("  %s\n", p)
+	}
+	return out
+}
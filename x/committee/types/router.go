@@ -0,0 +1,67 @@
+package types
+
+import (
+	"fmt"
+	"regexp"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// isAlphaNumeric defines a regular expression for matching against route names.
+var isAlphaNumeric = regexp.MustCompile(`^[a-zA-Z0-9]+$`).MatchString
+
+// Handler defines a function that handles a PubProposal after it has passed a committee vote
+type Handler func(ctx sdk.Context, pubProposal PubProposal) sdk.Error
+
+// Router defines the interface that the committee keeper uses to look up the Handler registered for a PubProposal's route
+type Router interface {
+	AddRoute(r string, h Handler) Router
+	HasRoute(r string) bool
+	GetRoute(r string) Handler
+	Seal()
+}
+
+type router struct {
+	routes map[string]Handler
+	sealed bool
+}
+
+// NewRouter creates a new, empty Router
+func NewRouter() Router {
+	return &router{
+		routes: make(map[string]Handler),
+	}
+}
+
+// Seal prevents the router from adding any more routes
+func (rtr *router) Seal() {
+	rtr.sealed = true
+}
+
+// AddRoute registers a Handler under a route name
+func (rtr *router) AddRoute(path string, h Handler) Router {
+	if rtr.sealed {
+		panic("router sealed; cannot add route")
+	}
+	if !isAlphaNumeric(path) {
+		panic("route expressions can only contain alphanumeric characters")
+	}
+	if rtr.HasRoute(path) {
+		panic(fmt.Sprintf("route %s has already been registered", path))
+	}
+	rtr.routes[path] = h
+	return rtr
+}
+
+// HasRoute returns whether a Handler has been registered for a route
+func (rtr *router) HasRoute(path string) bool {
+	return rtr.routes[path] != nil
+}
+
+// GetRoute returns the Handler registered for a route
+func (rtr *router) GetRoute(path string) Handler {
+	if !rtr.HasRoute(path) {
+		panic(fmt.Sprintf("route \"%s\" does not exist", path))
+	}
+	return rtr.routes[path]
+}
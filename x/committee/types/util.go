@@ -0,0 +1,15 @@
+package types
+
+import "encoding/binary"
+
+// Uint64ToBytes converts a uint64 into a big endian byte slice, suitable for use as (or as part of) a store key.
+func Uint64ToBytes(id uint64) []byte {
+	b := make([]byte, 8)
+	binary.BigEndian.PutUint64(b, id)
+	return b
+}
+
+// Uint64FromBytes converts a big endian encoded byte slice back into a uint64.
+func Uint64FromBytes(bz []byte) uint64 {
+	return binary.BigEndian.Uint64(bz)
+}
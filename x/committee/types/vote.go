@@ -0,0 +1,141 @@
+package types
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// VoteType indicates which way a voter voted
+type VoteType byte
+
+// Vote types
+const (
+	Yes VoteType = iota + 1
+	No
+	Abstain
+)
+
+// VoteTypeFromString converts a string into a VoteType
+func VoteTypeFromString(str string) (VoteType, error) {
+	switch strings.ToLower(str) {
+	case "yes":
+		return Yes, nil
+	case "no":
+		return No, nil
+	case "abstain":
+		return Abstain, nil
+	default:
+		return VoteType(0xff), fmt.Errorf("'%s' is not a valid vote type", str)
+	}
+}
+
+// Valid returns true if the VoteType is one of the recognized vote types
+func (vt VoteType) Valid() bool {
+	switch vt {
+	case Yes, No, Abstain:
+		return true
+	default:
+		return false
+	}
+}
+
+// String implements the fmt.Stringer interface
+func (vt VoteType) String() string {
+	switch vt {
+	case Yes:
+		return "yes"
+	case No:
+		return "no"
+	case Abstain:
+		return "abstain"
+	default:
+		return ""
+	}
+}
+
+// Marshal implements the gogo proto custom type interface
+func (vt VoteType) Marshal() ([]byte, error) {
+	return []byte{byte(vt)}, nil
+}
+
+// Unmarshal implements the gogo proto custom type interface
+func (vt *VoteType) Unmarshal(data []byte) error {
+	if len(data) == 0 {
+		*vt = VoteType(0x00)
+		return nil
+	}
+	*vt = VoteType(data[0])
+	return nil
+}
+
+// MarshalJSON marshals a VoteType to JSON using the string representation
+func (vt VoteType) MarshalJSON() ([]byte, error) {
+	return []byte(fmt.Sprintf("%q", vt.String())), nil
+}
+
+// UnmarshalJSON decodes a VoteType from JSON assuming the string representation was used
+func (vt *VoteType) UnmarshalJSON(data []byte) error {
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return err
+	}
+	parsed, err := VoteTypeFromString(s)
+	if err != nil {
+		return err
+	}
+	*vt = parsed
+	return nil
+}
+
+// Vote is a vote on a proposal
+type Vote struct {
+	ProposalID uint64         `json:"proposal_id" yaml:"proposal_id"`
+	Voter      sdk.AccAddress `json:"voter" yaml:"voter"`
+	VoteType   VoteType       `json:"vote_type" yaml:"vote_type"`
+}
+
+// NewVote instantiates a new Vote
+func NewVote(proposalID uint64, voter sdk.AccAddress, voteType VoteType) Vote {
+	return Vote{
+		ProposalID: proposalID,
+		Voter:      voter,
+		VoteType:   voteType,
+	}
+}
+
+// Validate performs basic validity checks on a Vote, returning an error for any failures
+func (v Vote) Validate() error {
+	if v.Voter.Empty() {
+		return fmt.Errorf("voter address cannot be empty")
+	}
+	if !v.VoteType.Valid() {
+		return fmt.Errorf("invalid vote type: %v", v.VoteType)
+	}
+	return nil
+}
+
+// String implements the fmt.Stringer interface
+func (v Vote) String() string {
+	return fmt.Sprintf(`Vote:
+  Proposal ID:  %d
+  Voter:        %s
+  Vote Type:    %s`, v.ProposalID, v.Voter, v.VoteType)
+}
+
+// Votes is a slice of Vote
+type Votes []Vote
+
+// String implements the fmt.Stringer interface
+func (vs Votes) String() string {
+	if len(vs) == 0 {
+		return "[]"
+	}
+	out := fmt.Sprintf("Votes for Proposal %d:", vs[0].ProposalID)
+	for _, v := range vs {
+		out += fmt.Sprintf("\n  %s - %s", v.Voter, v.VoteType)
+	}
+	return out
+}